@@ -0,0 +1,45 @@
+// Package flags holds the CLI flags this backlog's finality-policy work contributes to
+// the proposer's flag set. It is additive to the proposer's existing flags, which live
+// outside this change.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+const envVarPrefix = "OP_PROPOSER"
+
+func prefixEnvVars(name string) []string {
+	return []string{envVarPrefix + "_" + name}
+}
+
+var (
+	// FinalityPolicyFlag selects which proposer.FinalityPolicy gates proposals:
+	// "finalized" (default), "safe", "confirmations", or "custom".
+	FinalityPolicyFlag = &cli.StringFlag{
+		Name:    "finality-policy",
+		Usage:   "Which FinalityPolicy to gate proposals with: finalized, safe, confirmations, or custom",
+		EnvVars: prefixEnvVars("FINALITY_POLICY"),
+		Value:   "finalized",
+	}
+	// FinalityConfirmationsFlag sets N for the "confirmations" FinalityPolicy.
+	FinalityConfirmationsFlag = &cli.Uint64Flag{
+		Name:    "finality-confirmations",
+		Usage:   "Number of L1 confirmations required by the confirmations FinalityPolicy",
+		EnvVars: prefixEnvVars("FINALITY_CONFIRMATIONS"),
+	}
+	// FinalityCustomRPCMethodFlag sets the JSON-RPC method called by the "custom"
+	// FinalityPolicy to fetch the highest L2 block currently considered final.
+	FinalityCustomRPCMethodFlag = &cli.StringFlag{
+		Name:    "finality-custom-rpc-method",
+		Usage:   "JSON-RPC method the custom FinalityPolicy calls to fetch the highest final L2 block",
+		EnvVars: prefixEnvVars("FINALITY_CUSTOM_RPC_METHOD"),
+	}
+)
+
+// Flags is the set of CLI flags this package contributes to the proposer's flag set.
+var Flags = []cli.Flag{
+	FinalityPolicyFlag,
+	FinalityConfirmationsFlag,
+	FinalityCustomRPCMethodFlag,
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const Namespace = "op_proposer"
+
+// Metricer is the interface implemented by the proposer's metrics collector.
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	// RecordL2BlocksProposed records that the L2 block referenced by l2ref was
+	// successfully proposed.
+	RecordL2BlocksProposed(l2ref eth.L2BlockRef)
+
+	// RecordProposalReorged records that a previously submitted proposal was orphaned by
+	// an L1 reorg and had to be re-submitted.
+	RecordProposalReorged()
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  opmetrics.Factory
+
+	opmetrics.RefMetrics
+
+	Info prometheus.GaugeVec
+	Up   prometheus.Gauge
+
+	HighestL2BlockProposed prometheus.Gauge
+	ProposalReorgedTotal   prometheus.Counter
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	if procName == "" {
+		procName = "default"
+	}
+	ns := Namespace + "_" + procName
+
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		RefMetrics: opmetrics.MakeRefMetrics(ns, factory),
+
+		Info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Tracks version and config info",
+		}, []string{"version"}),
+		Up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the proposer has finished starting up",
+		}),
+		HighestL2BlockProposed: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "highest_l2_block_proposed",
+			Help:      "Highest L2 block number successfully proposed",
+		}),
+		ProposalReorgedTotal: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "proposal_reorged_total",
+			Help:      "Count of proposals that were orphaned by an L1 reorg and re-submitted",
+		}),
+	}
+}
+
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+func (m *Metrics) RecordInfo(version string) {
+	m.Info.WithLabelValues(version).Set(1)
+}
+
+func (m *Metrics) RecordUp() {
+	m.Up.Set(1)
+}
+
+func (m *Metrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
+	m.RecordL2Ref("proposed", l2ref)
+	m.HighestL2BlockProposed.Set(float64(l2ref.Number))
+}
+
+func (m *Metrics) RecordProposalReorged() {
+	m.ProposalReorgedTotal.Inc()
+}
@@ -0,0 +1,56 @@
+package proposer
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultRequiredConfirmations is used when ProposerConfig.RequiredConfirmations is left
+// at its zero value. A zero confirmation depth would make the reorg watcher consider a
+// proposal buried as soon as it lands in a single L1 block, i.e. no reorg protection at
+// all, so NewL2OutputSubmitter falls back to this instead of honoring an unset 0.
+const DefaultRequiredConfirmations = 10
+
+// ProposerConfig is the set of configuration options for the L2OutputSubmitter.
+type ProposerConfig struct {
+	// L2OutputOracleAddr is the address of the L2OutputOracle contract to submit proposals to.
+	// Mutually exclusive with DisputeGameFactoryAddr.
+	L2OutputOracleAddr *common.Address
+
+	// DisputeGameFactoryAddr is the address of the DisputeGameFactory contract to submit
+	// proposals to. Mutually exclusive with L2OutputOracleAddr.
+	DisputeGameFactoryAddr *common.Address
+
+	// DisputeGameType is the game type to use when creating new dispute games via the
+	// DisputeGameFactory.
+	DisputeGameType uint32
+
+	// PollInterval is the delay between consecutive checks for new L2 outputs to propose.
+	PollInterval time.Duration
+
+	// ProposalInterval is the fixed interval at which new dispute games are created, when
+	// submitting through the DisputeGameFactory.
+	ProposalInterval time.Duration
+
+	// OutputRetryInterval is the delay between retries when fetching an output to propose
+	// through the DisputeGameFactory fails.
+	OutputRetryInterval time.Duration
+
+	// NetworkTimeout is the allowed duration for a single network request.
+	NetworkTimeout time.Duration
+
+	// RequiredConfirmations is the number of L1 blocks a proposal's inclusion block must
+	// be built upon before the submitter stops watching it for reorgs. Defaults to
+	// DefaultRequiredConfirmations if left at 0.
+	RequiredConfirmations uint64
+
+	// FinalityPolicy decides which L2 blocks are safe to propose. It is constructed by
+	// CLI/service setup from the --finality-policy family of flags (finalized, safe,
+	// confirmations, custom) and defaults to FinalizedPolicy if left unset.
+	FinalityPolicy FinalityPolicy
+
+	// WaitNodeSync indicates whether to wait for the rollup node to sync to the current L1 tip
+	// before starting the proposer loop.
+	WaitNodeSync bool
+}
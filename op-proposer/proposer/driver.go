@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/oprpc"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -37,6 +38,10 @@ type L1Client interface {
 	// CallContract executes an Ethereum contract call with the specified data as the
 	// input.
 	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+
+	// EstimateGas tries to estimate the gas needed to execute a specific transaction.
+	// Used by the admin RPC's dry-run method to report a gas estimate without sending.
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
 }
 
 type L2OOContract interface {
@@ -58,6 +63,11 @@ type DriverSetup struct {
 
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
+
+	// RPC is optional. When set, the `proposer` admin namespace (proposeNow/dryRun/
+	// status) is registered on it alongside the existing metrics/HTTP surface when the
+	// submitter starts.
+	RPC *oprpc.Server
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -73,11 +83,42 @@ type L2OutputSubmitter struct {
 	mutex   sync.Mutex
 	running bool
 
+	// sendMu serializes everything that calls sendTransaction: the ticker-driven loop,
+	// the admin RPC's proposer_proposeNow, and reorg replays. It is deliberately
+	// separate from mutex, which only guards the running flag: mutex is held across StopL2OutputSubmitting's close(done)+wg.Wait(), and
+	// sendTransaction can block for a long time inside waitForL1Head, so holding mutex
+	// across a send would prevent Stop from ever reaching the close(done) that's
+	// needed to unblock it.
+	sendMu sync.Mutex
+
+	// statusMu guards lastProposedBlock/lastErr below. It is deliberately separate from
+	// mutex: StopL2OutputSubmitting holds mutex across close(done)+wg.Wait(), and a send
+	// that's in flight when done closes returns promptly and then calls setLastErr, so
+	// locking mutex there would deadlock Stop the same way sendMu exists to avoid.
+	statusMu sync.Mutex
+
+	// lastProposedBlock and lastErr back the `proposer_status` RPC method.
+	lastProposedBlock *uint64
+	lastErr           error
+
 	l2ooContract L2OOContract
 	l2ooABI      *abi.ABI
 
 	dgfContract *bindings.DisputeGameFactoryCaller
 	dgfABI      *abi.ABI
+
+	pendingMu        sync.Mutex
+	pendingProposals map[uint64]*pendingProposal
+}
+
+// pendingProposal tracks a submitted proposal that has not yet been buried by
+// Cfg.RequiredConfirmations L1 blocks, so that a shallow L1 reorg orphaning it, or an L2
+// reorg changing the output root it attests to, can be detected and replayed.
+type pendingProposal struct {
+	output      *eth.OutputResponse
+	txHash      common.Hash
+	blockHash   common.Hash
+	blockNumber uint64
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -92,6 +133,13 @@ func NewL2OutputSubmitter(setup DriverSetup) (_ *L2OutputSubmitter, err error) {
 		}
 	}()
 
+	if setup.Cfg.FinalityPolicy == nil {
+		setup.Cfg.FinalityPolicy = FinalizedPolicy{}
+	}
+	if setup.Cfg.RequiredConfirmations == 0 {
+		setup.Cfg.RequiredConfirmations = DefaultRequiredConfirmations
+	}
+
 	if setup.Cfg.L2OutputOracleAddr != nil {
 		return newL2OOSubmitter(ctx, cancel, setup)
 	} else if setup.Cfg.DisputeGameFactoryAddr != nil {
@@ -131,6 +179,8 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 
 		l2ooContract: l2ooContract,
 		l2ooABI:      parsed,
+
+		pendingProposals: make(map[uint64]*pendingProposal),
 	}, nil
 }
 
@@ -164,6 +214,8 @@ func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup Drive
 
 		dgfContract: dgfCaller,
 		dgfABI:      parsed,
+
+		pendingProposals: make(map[uint64]*pendingProposal),
 	}, nil
 }
 
@@ -178,6 +230,10 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	}
 	l.running = true
 
+	if l.RPC != nil {
+		RegisterAPIs(l.RPC, l)
+	}
+
 	l.wg.Add(1)
 	go l.loop()
 
@@ -250,13 +306,15 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 		return nil, false, fmt.Errorf("fetching output: %w", err)
 	}
 
-	// Always propose if it's part of the Finalized L2 chain. Or if allowed, if it's part of the safe L2 chain.
-	if output.BlockRef.Number > output.Status.FinalizedL2.Number && (!l.Cfg.AllowNonFinalized || output.BlockRef.Number > output.Status.SafeL2.Number) {
+	final, err := l.Cfg.FinalityPolicy.IsFinal(ctx, output)
+	if err != nil {
+		return nil, false, fmt.Errorf("checking output finality: %w", err)
+	}
+	if !final {
 		l.Log.Debug("Not proposing yet, L2 block is not ready for proposal",
 			"l2_proposal", output.BlockRef,
 			"l2_safe", output.Status.SafeL2,
-			"l2_finalized", output.Status.FinalizedL2,
-			"allow_non_finalized", l.Cfg.AllowNonFinalized)
+			"l2_finalized", output.Status.FinalizedL2)
 		return output, false, nil
 	}
 	return output, true, nil
@@ -276,8 +334,9 @@ func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResp
 	return l.FetchOutput(ctx, blockNum)
 }
 
-// FetchCurrentBlockNumber gets the current block number from the [L2OutputSubmitter]'s [RollupClient]. If the `AllowNonFinalized` configuration
-// option is set, it will return the safe head block number, and if not, it will return the finalized head block number.
+// FetchCurrentBlockNumber gets the current block number from the [L2OutputSubmitter]'s
+// [RollupClient], using the configured [FinalityPolicy] to decide which L2 head is safe
+// to propose up to.
 func (l *L2OutputSubmitter) FetchCurrentBlockNumber(ctx context.Context) (uint64, error) {
 	rollupClient, err := l.RollupProvider.RollupClient(ctx)
 	if err != nil {
@@ -289,11 +348,7 @@ func (l *L2OutputSubmitter) FetchCurrentBlockNumber(ctx context.Context) (uint64
 		return 0, fmt.Errorf("getting sync status: %w", err)
 	}
 
-	// Use either the finalized or safe head depending on the config. Finalized head is default & safer.
-	if l.Cfg.AllowNonFinalized {
-		return status.SafeL2.Number, nil
-	}
-	return status.FinalizedL2.Number, nil
+	return l.Cfg.FinalityPolicy.BlockNumber(ctx, status)
 }
 
 func (l *L2OutputSubmitter) FetchOutput(ctx context.Context, block uint64) (*eth.OutputResponse, error) {
@@ -376,10 +431,10 @@ func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64)
 }
 
 // sendTransaction creates & sends transactions through the underlying transaction manager.
-func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse) error {
+func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.OutputResponse) (*types.Receipt, error) {
 	err := l.waitForL1Head(ctx, output.Status.HeadL1.Number+1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	l.Log.Info("Proposing output root", "output", output.OutputRoot, "block", output.BlockRef)
@@ -387,7 +442,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	if l.Cfg.DisputeGameFactoryAddr != nil {
 		data, bond, err := l.ProposeL2OutputDGFTxData(output)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
 			TxData:   data,
@@ -396,12 +451,12 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 			Value:    bond,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		data, err := l.ProposeL2OutputTxData(output)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
 			TxData:   data,
@@ -409,7 +464,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 			GasLimit: 0,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -421,7 +476,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 			"l1blocknum", output.Status.CurrentL1.Number,
 			"l1blockhash", output.Status.CurrentL1.Hash)
 	}
-	return nil
+	return receipt, nil
 }
 
 // loop is responsible for creating & submitting the next outputs
@@ -536,17 +591,208 @@ func (l *L2OutputSubmitter) loopDGF(ctx context.Context) {
 	}
 }
 
-func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse) {
+// setLastProposed records a successfully proposed L2 block for proposer_status.
+func (l *L2OutputSubmitter) setLastProposed(blockNum uint64) {
+	l.statusMu.Lock()
+	defer l.statusMu.Unlock()
+	l.lastErr = nil
+	l.lastProposedBlock = &blockNum
+}
+
+// setLastErr records the most recent proposal failure for proposer_status.
+func (l *L2OutputSubmitter) setLastErr(err error) {
+	l.statusMu.Lock()
+	defer l.statusMu.Unlock()
+	l.lastErr = err
+}
+
+// proposeOutput sends a single proposal for output and returns its receipt. It is
+// guarded by sendMu so that it never races the admin RPC's proposer_proposeNow or a
+// reorg-replay submission.
+func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.OutputResponse) (*types.Receipt, error) {
+	l.sendMu.Lock()
+	defer l.sendMu.Unlock()
+
+	select {
+	case <-l.done:
+		return nil, fmt.Errorf("L2OutputSubmitter is done()")
+	default:
+	}
+
 	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
-	if err := l.sendTransaction(cCtx, output); err != nil {
+	receipt, err := l.sendTransaction(cCtx, output)
+	if err != nil {
 		l.Log.Error("Failed to send proposal transaction",
 			"err", err,
 			"l1blocknum", output.Status.CurrentL1.Number,
 			"l1blockhash", output.Status.CurrentL1.Hash,
 			"l1head", output.Status.HeadL1.Number)
-		return
+		l.setLastErr(err)
+		return nil, err
 	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		err := fmt.Errorf("proposal tx %s for L2 block %d reverted", receipt.TxHash, output.BlockRef.Number)
+		l.setLastErr(err)
+		return receipt, err
+	}
+
 	l.Metr.RecordL2BlocksProposed(output.BlockRef)
+	l.setLastProposed(output.BlockRef.Number)
+	l.trackPendingProposal(output, receipt)
+	return receipt, nil
+}
+
+// trackPendingProposal registers a successfully submitted proposal and starts a
+// background watcher that replays it if it is orphaned before it accrues
+// Cfg.RequiredConfirmations L1 confirmations.
+func (l *L2OutputSubmitter) trackPendingProposal(output *eth.OutputResponse, receipt *types.Receipt) {
+	pp := &pendingProposal{
+		output:      output,
+		txHash:      receipt.TxHash,
+		blockHash:   receipt.BlockHash,
+		blockNumber: receipt.BlockNumber.Uint64(),
+	}
+
+	l.pendingMu.Lock()
+	l.pendingProposals[output.BlockRef.Number] = pp
+	l.pendingMu.Unlock()
+
+	l.wg.Add(1)
+	go l.watchPendingProposal(pp)
+}
+
+// watchPendingProposal polls until pp is buried by Cfg.RequiredConfirmations L1 blocks,
+// re-submitting it if the L1 block that included it is reorg'd out, or if the L2 output
+// root it attests to changes before finalization.
+func (l *L2OutputSubmitter) watchPendingProposal(pp *pendingProposal) {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.Cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			next, done, err := l.checkPendingProposal(l.ctx, pp)
+			if err != nil {
+				l.Log.Warn("Failed to check pending proposal for reorgs", "err", err, "l2_block", pp.output.BlockRef.Number)
+				continue
+			}
+			if done {
+				l.pendingMu.Lock()
+				delete(l.pendingProposals, pp.output.BlockRef.Number)
+				l.pendingMu.Unlock()
+				return
+			}
+			pp = next
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// checkPendingProposal inspects the current L1 and L2 chains for reorgs affecting pp.
+// It returns the pendingProposal to keep watching (itself, or a replacement it just
+// submitted) and whether watching is done, either because pp is now buried deep enough
+// or because its L2OO block is already checkpointed with a different root and the
+// proposal is given up on.
+func (l *L2OutputSubmitter) checkPendingProposal(ctx context.Context, pp *pendingProposal) (*pendingProposal, bool, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+
+	currentOutput, err := l.FetchOutput(cCtx, pp.output.BlockRef.Number)
+	if err != nil {
+		return nil, false, fmt.Errorf("re-checking output at block %d: %w", pp.output.BlockRef.Number, err)
+	}
+	if currentOutput.OutputRoot != pp.output.OutputRoot {
+		if l.l2ooContract != nil {
+			// The L2OO checkpoints each block number exactly once. If this block is
+			// already checkpointed on-chain, the changed root reflects an L2 reorg that
+			// happened after the original proposal landed; re-submitting would just
+			// revert against the block already recorded, forever. There's nothing a
+			// proposer can do to fix an already-checkpointed block, so give up on it
+			// instead of busy-resubmitting.
+			nextBlock, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: cCtx})
+			if err != nil {
+				return nil, false, fmt.Errorf("checking whether block %d is already checkpointed: %w", pp.output.BlockRef.Number, err)
+			}
+			if nextBlock.Uint64() > pp.output.BlockRef.Number {
+				l.Log.Error("L2 output root changed for a block already checkpointed on the L2OO, giving up on this proposal",
+					"l2_block", pp.output.BlockRef.Number, "old_root", pp.output.OutputRoot, "new_root", currentOutput.OutputRoot)
+				return nil, true, nil
+			}
+		}
+		l.Log.Warn("L2 output root changed since proposal was submitted, re-submitting",
+			"l2_block", pp.output.BlockRef.Number, "old_root", pp.output.OutputRoot, "new_root", currentOutput.OutputRoot)
+		next, err := l.replaceProposal(ctx, pp)
+		return next, false, err
+	}
+
+	canonical, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(pp.blockNumber))
+	if err != nil {
+		return nil, false, fmt.Errorf("fetching canonical header at block %d: %w", pp.blockNumber, err)
+	}
+	if canonical.Hash() != pp.blockHash {
+		l.Log.Warn("Proposal tx was orphaned by an L1 reorg, re-submitting",
+			"l2_block", pp.output.BlockRef.Number, "tx_hash", pp.txHash, "orphaned_block_hash", pp.blockHash)
+		// Only the L1-reorg branch counts towards proposal_reorged_total: that's what
+		// the metric's name and help text describe. The L2-output-root-change branch
+		// above re-submits for a different reason and isn't an L1 reorg.
+		l.Metr.RecordProposalReorged()
+		next, err := l.replaceProposal(ctx, pp)
+		return next, false, err
+	}
+
+	l1Head, err := l.Txmgr.BlockNumber(cCtx)
+	if err != nil {
+		return nil, false, err
+	}
+	if l1Head < pp.blockNumber+l.Cfg.RequiredConfirmations {
+		return pp, false, nil
+	}
+	return pp, true, nil
+}
+
+// replaceProposal re-fetches the output for pp's L2 checkpoint and re-submits it after
+// a reorg orphaned the original proposal. It is guarded by sendMu, the same lock
+// proposeOutput uses, so a replay never races the ticker loop or proposer_proposeNow
+// for a concurrent Txmgr.Send.
+func (l *L2OutputSubmitter) replaceProposal(ctx context.Context, pp *pendingProposal) (*pendingProposal, error) {
+	l.sendMu.Lock()
+	defer l.sendMu.Unlock()
+
+	select {
+	case <-l.done:
+		return nil, fmt.Errorf("L2OutputSubmitter is done()")
+	default:
+	}
+
+	fresh, err := l.FetchOutput(ctx, pp.output.BlockRef.Number)
+	if err != nil {
+		return nil, fmt.Errorf("re-fetching output after reorg: %w", err)
+	}
+
+	receipt, err := l.sendTransaction(ctx, fresh)
+	if err != nil {
+		return nil, fmt.Errorf("re-submitting proposal after reorg: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("re-submitted proposal for L2 block %d reverted", fresh.BlockRef.Number)
+	}
+
+	replacement := &pendingProposal{
+		output:      fresh,
+		txHash:      receipt.TxHash,
+		blockHash:   receipt.BlockHash,
+		blockNumber: receipt.BlockNumber.Uint64(),
+	}
+
+	l.pendingMu.Lock()
+	l.pendingProposals[fresh.BlockRef.Number] = replacement
+	l.pendingMu.Unlock()
+
+	return replacement, nil
 }
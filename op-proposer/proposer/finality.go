@@ -0,0 +1,140 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// FinalityPolicy decides which L2 blocks the submitter is allowed to propose. It
+// replaces the old binary Cfg.AllowNonFinalized flag so that operators can tune the
+// proposer's safety/liveness tradeoff per rollup without code changes.
+type FinalityPolicy interface {
+	// BlockNumber returns the L2 block number, derived from the rollup node's current
+	// sync status, that this policy considers the right checkpoint to propose up to.
+	BlockNumber(ctx context.Context, status *eth.SyncStatus) (uint64, error)
+
+	// IsFinal reports whether the L2 block referenced by output has met this policy's
+	// finality bar, and is therefore safe to submit as a proposal.
+	IsFinal(ctx context.Context, output *eth.OutputResponse) (bool, error)
+}
+
+// FinalizedPolicy only proposes L2 blocks that have been finalized on L1. It is the
+// proposer's default, and the safest of the built-in policies.
+type FinalizedPolicy struct{}
+
+func (FinalizedPolicy) BlockNumber(_ context.Context, status *eth.SyncStatus) (uint64, error) {
+	return status.FinalizedL2.Number, nil
+}
+
+func (FinalizedPolicy) IsFinal(_ context.Context, output *eth.OutputResponse) (bool, error) {
+	return output.BlockRef.Number <= output.Status.FinalizedL2.Number, nil
+}
+
+// SafePolicy proposes L2 blocks as soon as they are safe, without waiting for them to
+// be finalized on L1. This is the policy previously selected via Cfg.AllowNonFinalized.
+type SafePolicy struct{}
+
+func (SafePolicy) BlockNumber(_ context.Context, status *eth.SyncStatus) (uint64, error) {
+	return status.SafeL2.Number, nil
+}
+
+func (SafePolicy) IsFinal(_ context.Context, output *eth.OutputResponse) (bool, error) {
+	return output.BlockRef.Number <= output.Status.SafeL2.Number, nil
+}
+
+// ConfirmationsPolicy considers an L2 block final once N L1 blocks have been built on
+// top of the L1 origin reported in OutputResponse.Status.CurrentL1. This suits chains
+// that want a tunable confirmation depth rather than L1's own safe/finalized semantics.
+type ConfirmationsPolicy struct {
+	N        uint64
+	L1Client L1Client
+}
+
+// confirmedAt reports whether l1Origin has accrued p.N L1 confirmations yet.
+func (p ConfirmationsPolicy) confirmedAt(ctx context.Context, l1Origin uint64) (bool, error) {
+	head, err := p.L1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("fetching L1 head: %w", err)
+	}
+	return head.Number.Uint64() >= l1Origin+p.N, nil
+}
+
+// BlockNumber returns the safe L2 head once it has accrued p.N L1 confirmations on top
+// of its L1 origin, and the finalized L2 head otherwise. Folding the confirmation depth
+// in here (rather than only in IsFinal) matters for the DGF loop, which proposes
+// whatever BlockNumber returns directly and never calls IsFinal.
+func (p ConfirmationsPolicy) BlockNumber(ctx context.Context, status *eth.SyncStatus) (uint64, error) {
+	confirmed, err := p.confirmedAt(ctx, status.CurrentL1.Number)
+	if err != nil {
+		return 0, err
+	}
+	if confirmed {
+		return status.SafeL2.Number, nil
+	}
+	return status.FinalizedL2.Number, nil
+}
+
+func (p ConfirmationsPolicy) IsFinal(ctx context.Context, output *eth.OutputResponse) (bool, error) {
+	return p.confirmedAt(ctx, output.Status.CurrentL1.Number)
+}
+
+// CustomRPCPolicy defers finality decisions to a user-configured JSON-RPC method that
+// returns the highest L2 block number currently considered final. This supports chains
+// with an external finality gadget the proposer has no other way to observe.
+type CustomRPCPolicy struct {
+	Client *rpc.Client
+	Method string
+}
+
+func (p CustomRPCPolicy) BlockNumber(ctx context.Context, _ *eth.SyncStatus) (uint64, error) {
+	var result hexutil.Uint64
+	if err := p.Client.CallContext(ctx, &result, p.Method); err != nil {
+		return 0, fmt.Errorf("calling custom finality method %s: %w", p.Method, err)
+	}
+	return uint64(result), nil
+}
+
+func (p CustomRPCPolicy) IsFinal(ctx context.Context, output *eth.OutputResponse) (bool, error) {
+	final, err := p.BlockNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	return output.BlockRef.Number <= final, nil
+}
+
+// Finality policy names accepted by the --finality-policy flag.
+const (
+	FinalityPolicyFinalized     = "finalized"
+	FinalityPolicySafe          = "safe"
+	FinalityPolicyConfirmations = "confirmations"
+	FinalityPolicyCustom        = "custom"
+)
+
+// NewFinalityPolicyFromFlags builds a FinalityPolicy from the --finality-policy family
+// of CLI flags (see op-proposer/flags). l1Client is required for "confirmations";
+// rpcClient and rpcMethod are required for "custom".
+func NewFinalityPolicyFromFlags(policyName string, confirmations uint64, l1Client L1Client, rpcClient *rpc.Client, rpcMethod string) (FinalityPolicy, error) {
+	switch policyName {
+	case "", FinalityPolicyFinalized:
+		return FinalizedPolicy{}, nil
+	case FinalityPolicySafe:
+		return SafePolicy{}, nil
+	case FinalityPolicyConfirmations:
+		if confirmations == 0 {
+			return nil, fmt.Errorf("finality-confirmations must be > 0 when finality-policy=%s", FinalityPolicyConfirmations)
+		}
+		return ConfirmationsPolicy{N: confirmations, L1Client: l1Client}, nil
+	case FinalityPolicyCustom:
+		if rpcClient == nil || rpcMethod == "" {
+			return nil, fmt.Errorf("finality-custom-rpc-method must be set when finality-policy=%s", FinalityPolicyCustom)
+		}
+		return CustomRPCPolicy{Client: rpcClient, Method: rpcMethod}, nil
+	default:
+		return nil, fmt.Errorf("unknown finality policy %q", policyName)
+	}
+}
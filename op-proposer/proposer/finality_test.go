@@ -0,0 +1,171 @@
+package proposer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// fakeL1Client implements L1Client, reporting a header at block number head from
+// HeaderByNumber regardless of the requested block number; CodeAt/CallContract/EstimateGas are unused by the
+// FinalityPolicy implementations under test.
+type fakeL1Client struct {
+	head uint64
+}
+
+func (f fakeL1Client) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(f.head)}, nil
+}
+
+func (f fakeL1Client) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (f fakeL1Client) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (f fakeL1Client) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 0, nil
+}
+
+func testStatus() *eth.SyncStatus {
+	return &eth.SyncStatus{
+		SafeL2:      eth.L2BlockRef{Number: 100},
+		FinalizedL2: eth.L2BlockRef{Number: 90},
+	}
+}
+
+func testOutput(l2Block, l1Origin uint64) *eth.OutputResponse {
+	return &eth.OutputResponse{
+		BlockRef: eth.L2BlockRef{Number: l2Block},
+		Status: &eth.SyncStatus{
+			SafeL2:      eth.L2BlockRef{Number: 100},
+			FinalizedL2: eth.L2BlockRef{Number: 90},
+			CurrentL1:   eth.L1BlockRef{Number: l1Origin},
+		},
+	}
+}
+
+func TestFinalizedPolicy(t *testing.T) {
+	p := FinalizedPolicy{}
+
+	num, err := p.BlockNumber(context.Background(), testStatus())
+	require.NoError(t, err)
+	require.Equal(t, uint64(90), num)
+
+	final, err := p.IsFinal(context.Background(), testOutput(90, 0))
+	require.NoError(t, err)
+	require.True(t, final)
+
+	final, err = p.IsFinal(context.Background(), testOutput(95, 0))
+	require.NoError(t, err)
+	require.False(t, final)
+}
+
+func TestSafePolicy(t *testing.T) {
+	p := SafePolicy{}
+
+	num, err := p.BlockNumber(context.Background(), testStatus())
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), num)
+
+	final, err := p.IsFinal(context.Background(), testOutput(100, 0))
+	require.NoError(t, err)
+	require.True(t, final)
+
+	final, err = p.IsFinal(context.Background(), testOutput(101, 0))
+	require.NoError(t, err)
+	require.False(t, final)
+}
+
+func TestConfirmationsPolicy(t *testing.T) {
+	// L1 origin 50, head 54: only 4 confirmations have accrued, N=5 is not yet met.
+	p := ConfirmationsPolicy{N: 5, L1Client: fakeL1Client{head: 54}}
+	status := testOutput(100, 50).Status
+
+	final, err := p.IsFinal(context.Background(), testOutput(100, 50))
+	require.NoError(t, err)
+	require.False(t, final)
+
+	num, err := p.BlockNumber(context.Background(), status)
+	require.NoError(t, err)
+	require.Equal(t, uint64(90), num, "not enough confirmations yet, falls back to the finalized head")
+
+	// Advancing the L1 head to 55 accrues the 5th confirmation.
+	p.L1Client = fakeL1Client{head: 55}
+
+	final, err = p.IsFinal(context.Background(), testOutput(100, 50))
+	require.NoError(t, err)
+	require.True(t, final)
+
+	num, err = p.BlockNumber(context.Background(), status)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), num, "confirmations met, the safe head is now proposable")
+}
+
+func TestCustomRPCPolicy(t *testing.T) {
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	require.NoError(t, server.RegisterName("finality", new(customFinalityService)))
+
+	client := rpc.DialInProc(server)
+	t.Cleanup(client.Close)
+
+	p := CustomRPCPolicy{Client: client, Method: "finality_highestFinal"}
+
+	num, err := p.BlockNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), num)
+
+	final, err := p.IsFinal(context.Background(), testOutput(42, 0))
+	require.NoError(t, err)
+	require.True(t, final)
+
+	final, err = p.IsFinal(context.Background(), testOutput(43, 0))
+	require.NoError(t, err)
+	require.False(t, final)
+}
+
+// customFinalityService backs the in-process RPC server used by TestCustomRPCPolicy. It
+// returns hexutil.Uint64, matching what CustomRPCPolicy.BlockNumber decodes into; a real
+// external finality method must hex-encode its result the same way.
+type customFinalityService struct{}
+
+func (customFinalityService) HighestFinal() (hexutil.Uint64, error) {
+	return hexutil.Uint64(42), nil
+}
+
+func TestNewFinalityPolicyFromFlags(t *testing.T) {
+	l1Client := fakeL1Client{head: 100}
+
+	p, err := NewFinalityPolicyFromFlags("", 0, l1Client, nil, "")
+	require.NoError(t, err)
+	require.IsType(t, FinalizedPolicy{}, p)
+
+	p, err = NewFinalityPolicyFromFlags(FinalityPolicySafe, 0, l1Client, nil, "")
+	require.NoError(t, err)
+	require.IsType(t, SafePolicy{}, p)
+
+	p, err = NewFinalityPolicyFromFlags(FinalityPolicyConfirmations, 5, l1Client, nil, "")
+	require.NoError(t, err)
+	require.IsType(t, ConfirmationsPolicy{}, p)
+
+	_, err = NewFinalityPolicyFromFlags(FinalityPolicyConfirmations, 0, l1Client, nil, "")
+	require.Error(t, err)
+
+	_, err = NewFinalityPolicyFromFlags(FinalityPolicyCustom, 0, l1Client, nil, "")
+	require.Error(t, err)
+
+	_, err = NewFinalityPolicyFromFlags("bogus", 0, l1Client, nil, "")
+	require.Error(t, err)
+}
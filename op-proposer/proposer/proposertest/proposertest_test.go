@@ -0,0 +1,265 @@
+package proposertest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/bindings"
+	"github.com/ethereum-optimism/optimism/op-proposer/proposer"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// simChainID is the chain ID go-ethereum's ethclient/simulated backend configures by
+// default.
+var simChainID = big.NewInt(1337)
+
+func newDeployer(t *testing.T) *bind.TransactOpts {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, simChainID)
+	require.NoError(t, err)
+	return opts
+}
+
+func defaultOracleConfig(deployer common.Address) OracleConfig {
+	return OracleConfig{
+		SubmissionInterval:    big.NewInt(1),
+		L2BlockTime:           big.NewInt(2),
+		StartingBlockNumber:   big.NewInt(0),
+		StartingTimestamp:     big.NewInt(0),
+		StartingOutputRoot:    [32]byte{},
+		Proposer:              deployer,
+		Challenger:            deployer,
+		FinalizationPeriodSec: big.NewInt(0),
+	}
+}
+
+// scriptOutput points the harness's StubRollupClient at a fresh output root for l2Block,
+// using the simulated chain's current L1 head as the output's L1 origin. HeadL1 is left
+// at genesis: sendTransaction's waitForL1Head waits for the L1 head to pass HeadL1+1, so
+// the L1 head must be at block 2 or later before a send goes through, and a scenario that wants to exercise that wait specifically (TestWaitForL1HeadRace)
+// overrides HeadL1 itself afterwards.
+func scriptOutput(t *testing.T, h *Harness, l2Block uint64, outputRoot [32]byte) {
+	head, err := h.Client.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+
+	h.Rollup.Status = &eth.SyncStatus{
+		SafeL2:      eth.L2BlockRef{Number: l2Block},
+		FinalizedL2: eth.L2BlockRef{Number: l2Block},
+		CurrentL1:   eth.L1BlockRef{Number: head.Number.Uint64(), Hash: head.Hash()},
+		HeadL1:      eth.L1BlockRef{Number: 0},
+	}
+	h.Rollup.Outputs[l2Block] = &eth.OutputResponse{
+		OutputRoot: outputRoot,
+		BlockRef:   eth.L2BlockRef{Number: l2Block},
+		Status:     h.Rollup.Status,
+	}
+}
+
+func nextL2OOBlock(t *testing.T, h *Harness) uint64 {
+	caller, err := bindings.NewL2OutputOracleCaller(h.L2OOAddr, h.Client)
+	require.NoError(t, err)
+	next, err := caller.NextBlockNumber(&bind.CallOpts{})
+	require.NoError(t, err)
+	return next.Uint64()
+}
+
+// TestHappyPath exercises the ordinary L2OO proposal path end to end: a scripted output
+// that is ready to propose gets submitted as a real transaction against a real deployed
+// L2OutputOracle, and the oracle's on-chain checkpoint advances past it.
+func TestHappyPath(t *testing.T) {
+	deployer := newDeployer(t)
+	h, err := NewHarness(deployer, defaultOracleConfig(deployer.From))
+	require.NoError(t, err)
+
+	nextBlock := nextL2OOBlock(t, h)
+	scriptOutput(t, h, nextBlock, [32]byte{0x01})
+	// Only the oracle deployment has been mined (L1 head 1); mine one more block so
+	// waitForL1Head's head > HeadL1+1 holds and the output's L1 origin is below the head.
+	h.AdvanceL1(1)
+
+	txMgr := NewSimTxManager(h.Backend, h.Client, deployer)
+	submitter, err := h.NewSubmitter(proposer.ProposerConfig{
+		L2OutputOracleAddr: &h.L2OOAddr,
+		PollInterval:       10 * time.Millisecond,
+		NetworkTimeout:     5 * time.Second,
+		FinalityPolicy:     proposer.SafePolicy{},
+	}, txMgr)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	txHash, err := proposer.NewAdminAPI(submitter).ProposeNow(ctx, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, common.Hash{}, txHash)
+
+	require.Greater(t, nextL2OOBlock(t, h), nextBlock, "the oracle's checkpoint must advance past the proposed block")
+}
+
+// TestWaitForL1HeadRace covers the race waitForL1Head exists to guard against: a
+// proposal whose L1 origin (Status.CurrentL1) and L1 head (Status.HeadL1) are still the
+// current L1 head, so blockhash(l1blocknum) would read as 0 inside the contract unless
+// the submitter waits for more L1 blocks before sending.
+func TestWaitForL1HeadRace(t *testing.T) {
+	deployer := newDeployer(t)
+	h, err := NewHarness(deployer, defaultOracleConfig(deployer.From))
+	require.NoError(t, err)
+
+	nextBlock := nextL2OOBlock(t, h)
+	scriptOutput(t, h, nextBlock, [32]byte{0x02})
+
+	// Point HeadL1 at the chain's current head, reproducing l1head == blocknum.
+	head, err := h.Client.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	h.Rollup.Status.HeadL1 = eth.L1BlockRef{Number: head.Number.Uint64()}
+
+	txMgr := NewSimTxManager(h.Backend, h.Client, deployer)
+	submitter, err := h.NewSubmitter(proposer.ProposerConfig{
+		L2OutputOracleAddr: &h.L2OOAddr,
+		PollInterval:       10 * time.Millisecond,
+		NetworkTimeout:     5 * time.Second,
+		FinalityPolicy:     proposer.SafePolicy{},
+	}, txMgr)
+	require.NoError(t, err)
+
+	// waitForL1Head will block until the L1 head advances past HeadL1.Number+1; mine the
+	// blocks it's waiting for from another goroutine.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		h.AdvanceL1(2)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	txHash, err := proposer.NewAdminAPI(submitter).ProposeNow(ctx, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, common.Hash{}, txHash)
+}
+
+// TestDGFBondPayment verifies that proposing through the DisputeGameFactory forwards
+// exactly the bond InitBonds reports for the configured game type, rather than a
+// hardcoded or zero value.
+func TestDGFBondPayment(t *testing.T) {
+	deployer := newDeployer(t)
+	h, err := NewHarness(deployer, defaultOracleConfig(deployer.From))
+	require.NoError(t, err)
+	require.NoError(t, h.DeployDGF())
+
+	const gameType = uint32(0)
+	dgfCaller, err := bindings.NewDisputeGameFactoryCaller(h.DGFAddr, h.Client)
+	require.NoError(t, err)
+	bond, err := dgfCaller.InitBonds(&bind.CallOpts{}, gameType)
+	require.NoError(t, err)
+
+	scriptOutput(t, h, 1, [32]byte{0x03})
+
+	ctx := context.Background()
+	balanceBefore, err := h.Client.BalanceAt(ctx, h.DGFAddr, nil)
+	require.NoError(t, err)
+
+	txMgr := NewSimTxManager(h.Backend, h.Client, deployer)
+	submitter, err := h.NewSubmitter(proposer.ProposerConfig{
+		DisputeGameFactoryAddr: &h.DGFAddr,
+		DisputeGameType:        gameType,
+		PollInterval:           10 * time.Millisecond,
+		NetworkTimeout:         5 * time.Second,
+		FinalityPolicy:         proposer.SafePolicy{},
+	}, txMgr)
+	require.NoError(t, err)
+
+	cCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = proposer.NewAdminAPI(submitter).ProposeNow(cCtx, uintPtr(1))
+	require.NoError(t, err)
+
+	balanceAfter, err := h.Client.BalanceAt(ctx, h.DGFAddr, nil)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(balanceBefore, bond), balanceAfter,
+		"the DisputeGameFactory must receive exactly its configured InitBonds value")
+}
+
+// TestOracleRevert proposes a block number the L2OutputOracle does not expect next, so
+// the contract's own bounds check reverts the call, and checks that the revert reaches
+// the caller as an error rather than being swallowed as a successful proposal.
+func TestOracleRevert(t *testing.T) {
+	deployer := newDeployer(t)
+	h, err := NewHarness(deployer, defaultOracleConfig(deployer.From))
+	require.NoError(t, err)
+
+	nextBlock := nextL2OOBlock(t, h)
+	wrongBlock := nextBlock + 100
+	scriptOutput(t, h, wrongBlock, [32]byte{0x04})
+	// Mine past waitForL1Head's HeadL1+1 bar, so the error comes from the oracle rather
+	// than from waiting on the L1 head until the context expires.
+	h.AdvanceL1(1)
+
+	txMgr := NewSimTxManager(h.Backend, h.Client, deployer)
+	submitter, err := h.NewSubmitter(proposer.ProposerConfig{
+		L2OutputOracleAddr: &h.L2OOAddr,
+		PollInterval:       10 * time.Millisecond,
+		NetworkTimeout:     5 * time.Second,
+		FinalityPolicy:     proposer.SafePolicy{},
+	}, txMgr)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = proposer.NewAdminAPI(submitter).ProposeNow(ctx, &wrongBlock)
+	require.Error(t, err)
+	require.Equal(t, nextBlock, nextL2OOBlock(t, h), "a reverted proposal must not advance the oracle's checkpoint")
+}
+
+// TestReorgReplay exercises the chunk0-1 reorg watcher's re-submission path: an L2
+// output root changing for an already-proposed block. It uses the DGF rather than the
+// L2OO path: the L2OO checkpoints each block number exactly once, so a replay against the
+// same block number always reverts (see checkPendingProposal's l2ooContract check) and
+// the watcher is expected to give up rather than resubmit; the DGF instead creates a
+// fresh dispute game per distinct output root, so a genuine successful replay is
+// observable. go-ethereum's simulated.Backend also has no API to fork or re-org the L1
+// chain it produces, which is why this drives the output-root-change trigger instead of a
+// true L1 reorg.
+func TestReorgReplay(t *testing.T) {
+	deployer := newDeployer(t)
+	h, err := NewHarness(deployer, defaultOracleConfig(deployer.From))
+	require.NoError(t, err)
+	require.NoError(t, h.DeployDGF())
+
+	const l2Block = uint64(1)
+	scriptOutput(t, h, l2Block, [32]byte{0x05})
+
+	txMgr := NewSimTxManager(h.Backend, h.Client, deployer)
+	submitter, err := h.NewSubmitter(proposer.ProposerConfig{
+		DisputeGameFactoryAddr: &h.DGFAddr,
+		PollInterval:           10 * time.Millisecond,
+		NetworkTimeout:         5 * time.Second,
+		FinalityPolicy:         proposer.SafePolicy{},
+		RequiredConfirmations:  1000, // keep the watcher from considering this buried during the test
+	}, txMgr)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = proposer.NewAdminAPI(submitter).ProposeNow(ctx, uintPtr(l2Block))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, txMgr.SuccessCount())
+
+	// Simulate an L2 reorg that produced a different output root for the same block.
+	scriptOutput(t, h, l2Block, [32]byte{0x06})
+
+	require.Eventually(t, func() bool {
+		return txMgr.SuccessCount() == 2
+	}, 5*time.Second, 20*time.Millisecond, "the watcher must successfully replay the proposal once it observes the output root change")
+}
+
+func uintPtr(v uint64) *uint64 { return &v }
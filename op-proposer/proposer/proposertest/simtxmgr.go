@@ -0,0 +1,126 @@
+package proposertest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+)
+
+// SimTxManager implements the subset of txmgr.TxManager the proposer uses (From, Send,
+// BlockNumber), backed by a simulated.Backend instead of a real L1 node. It signs with
+// signer and mines a block after every send, so tests see proposal transactions go
+// through the real calldata-building and receipt-checking path instead of a mock.
+type SimTxManager struct {
+	backend *simulated.Backend
+	client  simulated.Client
+	signer  *bind.TransactOpts
+
+	sendCount    atomic.Int64
+	successCount atomic.Int64
+}
+
+var _ txmgr.TxManager = (*SimTxManager)(nil)
+
+func NewSimTxManager(backend *simulated.Backend, client simulated.Client, signer *bind.TransactOpts) *SimTxManager {
+	return &SimTxManager{backend: backend, client: client, signer: signer}
+}
+
+func (m *SimTxManager) From() common.Address {
+	return m.signer.From
+}
+
+// SendCount reports how many transactions Send has mined so far, regardless of whether
+// they reverted. Together with SuccessCount, it lets tests observe reorg-replay behavior
+// that has no other externally visible signal.
+func (m *SimTxManager) SendCount() int64 {
+	return m.sendCount.Load()
+}
+
+// SuccessCount reports how many of those mined transactions succeeded (did not revert).
+func (m *SimTxManager) SuccessCount() int64 {
+	return m.successCount.Load()
+}
+
+func (m *SimTxManager) BlockNumber(ctx context.Context) (uint64, error) {
+	header, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// Send builds, signs and sends candidate as a transaction, mines it in its own block,
+// and returns the mined receipt.
+func (m *SimTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	nonce, err := m.client.PendingNonceAt(ctx, m.signer.From)
+	if err != nil {
+		return nil, fmt.Errorf("fetching nonce: %w", err)
+	}
+	gasTipCap, err := m.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggesting gas tip cap: %w", err)
+	}
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching head: %w", err)
+	}
+	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+
+	value := candidate.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	gasLimit := candidate.GasLimit
+	if gasLimit == 0 {
+		estimated, err := m.client.EstimateGas(ctx, ethereum.CallMsg{
+			From:  m.signer.From,
+			To:    candidate.To,
+			Value: value,
+			Data:  candidate.TxData,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("estimating gas: %w", err)
+		}
+		gasLimit = estimated
+	}
+
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   m.signer.Signer.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        candidate.To,
+		Value:     value,
+		Data:      candidate.TxData,
+	})
+
+	signedTx, err := m.signer.Signer(m.signer.From, tx)
+	if err != nil {
+		return nil, fmt.Errorf("signing tx: %w", err)
+	}
+	if err := m.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("sending tx: %w", err)
+	}
+	m.backend.Commit()
+
+	receipt, err := bind.WaitMined(ctx, m.client, signedTx)
+	if err != nil {
+		return nil, err
+	}
+	m.sendCount.Add(1)
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		m.successCount.Add(1)
+	}
+	return receipt, nil
+}
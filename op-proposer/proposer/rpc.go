@@ -0,0 +1,155 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/oprpc"
+)
+
+// ProposerNamespace is the RPC namespace the admin API is served under, e.g.
+// `proposer_proposeNow`.
+const ProposerNamespace = "proposer"
+
+// ProposerStatus reports the current state of the proposer loop, as returned by
+// `proposer_status`.
+type ProposerStatus struct {
+	Running           bool    `json:"running"`
+	LastProposedBlock *uint64 `json:"lastProposedBlock,omitempty"`
+	LastError         string  `json:"lastError,omitempty"`
+}
+
+// DryRunResult reports the outcome of simulating a proposal without sending it, as
+// returned by `proposer_dryRun`.
+type DryRunResult struct {
+	GasEstimate  hexutil.Uint64 `json:"gasEstimate"`
+	RevertReason string         `json:"revertReason,omitempty"`
+}
+
+// AdminAPI exposes the `proposer` RPC namespace alongside the proposer's existing
+// metrics/HTTP surface. It lets operators trigger an out-of-band proposal, dry-run one
+// without sending it, and inspect the loop's status, without restarting the daemon.
+type AdminAPI struct {
+	l *L2OutputSubmitter
+}
+
+func NewAdminAPI(l *L2OutputSubmitter) *AdminAPI {
+	return &AdminAPI{l: l}
+}
+
+// RegisterAPIs registers the `proposer` namespace on server, so it is served alongside
+// the proposer's existing metrics/HTTP surface.
+func RegisterAPIs(server *oprpc.Server, l *L2OutputSubmitter) {
+	server.AddAPI(gethrpc.API{
+		Namespace: ProposerNamespace,
+		Service:   NewAdminAPI(l),
+	})
+}
+
+// ProposeNow triggers a single iteration of the L2OO/DGF proposal loop out-of-band. If
+// blockNumber is nil, the L2OO's own NextBlockNumber (or the DGF's current checkpoint)
+// is used, just like the ticker-driven loop; otherwise the given L2 block is proposed
+// directly. It is guarded by the same sendMu as the ticker loop, so it never races with
+// a proposal already in flight. The tx hash is returned even if the proposal reverted,
+// so the caller can look the receipt up; the error reports the revert.
+func (a *AdminAPI) ProposeNow(ctx context.Context, blockNumber *uint64) (common.Hash, error) {
+	output, err := a.l.outputForBlock(ctx, blockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	receipt, err := a.l.proposeOutput(ctx, output)
+	if receipt != nil {
+		return receipt.TxHash, err
+	}
+	return common.Hash{}, err
+}
+
+// DryRun builds the calldata for the output at blockNumber and simulates it via
+// L1Client.CallContract without sending, returning a gas estimate or a revert reason.
+func (a *AdminAPI) DryRun(ctx context.Context, blockNumber uint64) (*DryRunResult, error) {
+	output, err := a.l.FetchOutput(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		data  []byte
+		to    *common.Address
+		value *big.Int
+	)
+	if a.l.Cfg.DisputeGameFactoryAddr != nil {
+		data, value, err = a.l.ProposeL2OutputDGFTxData(output)
+		to = a.l.Cfg.DisputeGameFactoryAddr
+	} else {
+		data, err = a.l.ProposeL2OutputTxData(output)
+		to = a.l.Cfg.L2OutputOracleAddr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("building proposal calldata: %w", err)
+	}
+
+	msg := ethereum.CallMsg{
+		From:  a.l.Txmgr.From(),
+		To:    to,
+		Value: value,
+		Data:  data,
+	}
+
+	if _, err := a.l.L1Client.CallContract(ctx, msg, nil); err != nil {
+		return &DryRunResult{RevertReason: err.Error()}, nil
+	}
+
+	gas, err := a.l.L1Client.EstimateGas(ctx, msg)
+	if err != nil {
+		return &DryRunResult{RevertReason: err.Error()}, nil
+	}
+	return &DryRunResult{GasEstimate: hexutil.Uint64(gas)}, nil
+}
+
+// Status reports whether the proposal loop is running, the last block it proposed, and
+// its last error, if any.
+func (a *AdminAPI) Status(context.Context) (*ProposerStatus, error) {
+	a.l.mutex.Lock()
+	running := a.l.running
+	a.l.mutex.Unlock()
+
+	a.l.statusMu.Lock()
+	defer a.l.statusMu.Unlock()
+
+	status := &ProposerStatus{Running: running}
+	if a.l.lastProposedBlock != nil {
+		b := *a.l.lastProposedBlock
+		status.LastProposedBlock = &b
+	}
+	if a.l.lastErr != nil {
+		status.LastError = a.l.lastErr.Error()
+	}
+	return status, nil
+}
+
+// outputForBlock fetches the output to propose: for a specific blockNumber if given, or
+// otherwise whatever the L2OO/DGF loop would itself propose next.
+func (l *L2OutputSubmitter) outputForBlock(ctx context.Context, blockNumber *uint64) (*eth.OutputResponse, error) {
+	if blockNumber != nil {
+		return l.FetchOutput(ctx, *blockNumber)
+	}
+	if l.dgfContract != nil {
+		return l.FetchDGFOutput(ctx)
+	}
+	output, shouldPropose, err := l.FetchL2OOOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !shouldPropose {
+		return nil, fmt.Errorf("no output is ready to propose yet")
+	}
+	return output, nil
+}